@@ -0,0 +1,57 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ztripez/mcp-dap/tests/fixtures/sample"
+)
+
+func main() {
+	scenario := flag.String("scenario", "greet", "scenario to run: greet|sum|hash|panic|goroutines")
+	name := flag.String("name", "World", "name to greet (greet scenario)")
+	iterations := flag.Int("iterations", 10, "number of chunks to hash (hash scenario)")
+	workers := flag.Int("workers", 4, "number of goroutines to spawn (goroutines scenario)")
+	flag.Parse()
+
+	start := time.Now()
+	var stats sample.Stats
+	defer func() {
+		stats.Elapsed = time.Since(start)
+		fmt.Fprintln(os.Stderr, "--- run summary ---")
+		fmt.Fprintln(os.Stderr, stats.Summary())
+	}()
+
+	switch *scenario {
+	case "greet":
+		sample.Greet(os.Stdout, *name)
+		stats.Ops++
+		stats.Succeeded++
+	case "sum":
+		fmt.Printf("Sum: %d\n", sample.Add(2, 3))
+		stats.Ops++
+		stats.Succeeded++
+	case "hash":
+		sum, bytesHashed := sample.Hash(*iterations)
+		fmt.Printf("Hash: %x\n", sum)
+		stats.Ops++
+		stats.Succeeded++
+		stats.BytesHashed = bytesHashed
+	case "goroutines":
+		results := sample.Goroutines(*workers)
+		fmt.Printf("Results: %v\n", results)
+		stats.Ops += len(results)
+		stats.Succeeded += len(results)
+	case "panic":
+		stats.Ops++
+		if err := sample.Panic(); err != nil {
+			fmt.Println(err)
+		} else {
+			stats.Succeeded++
+		}
+	default:
+		fmt.Printf("unknown scenario: %s\n", *scenario)
+	}
+}
@@ -0,0 +1,99 @@
+// Package sample implements the scenarios used by the mcp-dap sample
+// debuggee: a small set of operations chosen to give a DAP client something
+// concrete to set breakpoints in, step through, and inspect.
+package sample
+
+import (
+	"crypto/md5"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// Stats tracks the outcome of a single sample run: how many operations ran,
+// how many of those succeeded, how long the run took, and (for the hash
+// scenario) how many bytes were hashed. main prints it as a summary block to
+// stderr at exit, giving a DAP client a stable stream of output events to
+// assert against.
+type Stats struct {
+	Ops         int
+	Succeeded   int
+	Elapsed     time.Duration
+	BytesHashed int64
+}
+
+// Summary renders s as a stable, parseable line of key=value pairs.
+func (s Stats) Summary() string {
+	return fmt.Sprintf("ops=%d succeeded=%d elapsed=%s bytes_hashed=%d", s.Ops, s.Succeeded, s.Elapsed, s.BytesHashed)
+}
+
+// Greet writes a greeting for name to w and returns the message, so callers
+// can assert on both the returned value and the printed output.
+func Greet(w io.Writer, name string) string {
+	message := fmt.Sprintf("Hello, %s!", name)
+	fmt.Fprintln(w, message)
+	return message
+}
+
+// Add returns the sum of a and b.
+func Add(a, b int) int {
+	return a + b
+}
+
+// Hash hashes iterations chunks of data with MD5, breaking the work up so a
+// debugger can set a breakpoint inside the loop and inspect the running
+// hash.Hash state between chunks. It returns the digest along with the
+// total number of bytes written to the hash, for callers that report stats.
+func Hash(iterations int) (sum [md5.Size]byte, bytesHashed int64) {
+	h := md5.New()
+	for i := 0; i < iterations; i++ {
+		chunk := []byte(fmt.Sprintf("chunk-%d", i))
+		n, _ := h.Write(chunk)
+		bytesHashed += int64(n)
+	}
+	copy(sum[:], h.Sum(nil))
+	return sum, bytesHashed
+}
+
+// Goroutines spawns n workers that each receive a single job over a shared
+// channel, giving a debugger something to pause across when exercising the
+// goroutines/threads view. It returns nil if n is not positive.
+func Goroutines(n int) []int {
+	if n <= 0 {
+		return nil
+	}
+
+	jobs := make(chan int, n)
+	results := make([]int, n)
+	var wg sync.WaitGroup
+
+	for w := 0; w < n; w++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			job := <-jobs
+			results[id] = job * job
+		}(w)
+	}
+
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return results
+}
+
+// Panic deliberately panics and recovers, giving a debugger a target for the
+// DAP exception-breakpoint filters.
+func Panic() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered from panic: %v", r)
+		}
+	}()
+	var items []int
+	_ = items[0]
+	return nil
+}
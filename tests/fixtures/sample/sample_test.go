@@ -0,0 +1,134 @@
+package sample
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+)
+
+func TestGreet(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"basic name", "World", "Hello, World!"},
+		{"empty name", "", "Hello, !"},
+		{"name with punctuation", "O'Brien", "Hello, O'Brien!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			got := Greet(&buf, tt.in)
+			if got != tt.want {
+				t.Errorf("Greet(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+			if buf.String() != tt.want+"\n" {
+				t.Errorf("Greet(%q) wrote %q, want %q", tt.in, buf.String(), tt.want+"\n")
+			}
+		})
+	}
+}
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b int
+		want int
+	}{
+		{"positive", 2, 3, 5},
+		{"negative", -2, -3, -5},
+		{"mixed", -2, 3, 1},
+		{"zero", 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Add(tt.a, tt.b); got != tt.want {
+				t.Errorf("Add(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGoroutinesNonPositive(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+	}{
+		{"zero", 0},
+		{"negative", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Goroutines(tt.n); got != nil {
+				t.Errorf("Goroutines(%d) = %v, want nil", tt.n, got)
+			}
+		})
+	}
+}
+
+func TestHash(t *testing.T) {
+	tests := []struct {
+		name       string
+		iterations int
+		wantSum    string
+		wantBytes  int64
+	}{
+		{"zero iterations", 0, "d41d8cd98f00b204e9800998ecf8427e", 0},
+		{"one chunk", 1, "16ee312909a33e40d7b6a0b2bd29a6e6", 7},
+		{"three chunks", 3, "042b3a8998162986cba61f57f6829e21", 21},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sum, bytesHashed := Hash(tt.iterations)
+			if got := fmt.Sprintf("%x", sum); got != tt.wantSum {
+				t.Errorf("Hash(%d) sum = %s, want %s", tt.iterations, got, tt.wantSum)
+			}
+			if bytesHashed != tt.wantBytes {
+				t.Errorf("Hash(%d) bytesHashed = %d, want %d", tt.iterations, bytesHashed, tt.wantBytes)
+			}
+		})
+	}
+}
+
+func TestPanic(t *testing.T) {
+	err := Panic()
+	if err == nil {
+		t.Fatal("Panic() = nil, want a wrapped recovered-panic error")
+	}
+	if !bytes.Contains([]byte(err.Error()), []byte("recovered from panic")) {
+		t.Errorf("Panic() error = %q, want it to mention the recovered panic", err.Error())
+	}
+}
+
+func TestGoroutines(t *testing.T) {
+	const n = 4
+	got := Goroutines(n)
+	if len(got) != n {
+		t.Fatalf("Goroutines(%d) returned %d results, want %d", n, len(got), n)
+	}
+	seen := make(map[int]bool, n)
+	for _, v := range got {
+		seen[v] = true
+	}
+	for i := 0; i < n; i++ {
+		if want := i * i; !seen[want] {
+			t.Errorf("Goroutines(%d) = %v, want it to contain %d", n, got, want)
+		}
+	}
+}
+
+func ExampleGreet() {
+	Greet(os.Stdout, "World")
+	// Output: Hello, World!
+}
+
+func ExampleAdd() {
+	fmt.Println(Add(2, 3))
+	// Output: 5
+}